@@ -0,0 +1,114 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MnemoShare/projectboard-mcp/internal/taskboard"
+)
+
+// BulkToolResult is the response shape for bulk_update_tasks and
+// bulk_create_tasks: a pass/fail summary plus the full per-item results, so
+// an agent can act on partial success without re-parsing error strings.
+type BulkToolResult struct {
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+	Errors    []BulkItemError        `json:"errors,omitempty"`
+	Results   []taskboard.BulkResult `json:"results"`
+}
+
+type BulkItemError struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+func summarizeBulkResults(results []taskboard.BulkResult) BulkToolResult {
+	summary := BulkToolResult{Results: results}
+	for _, r := range results {
+		if r.Success {
+			summary.Succeeded++
+			continue
+		}
+		summary.Failed++
+		summary.Errors = append(summary.Errors, BulkItemError{ID: r.ID, Error: r.Error})
+	}
+	return summary
+}
+
+func (s *Server) bulkUpdateTasks(ctx context.Context, client *taskboard.Client, args map[string]interface{}) (interface{}, error) {
+	rawItems, ok := args["items"].([]interface{})
+	if !ok || len(rawItems) == 0 {
+		return nil, fmt.Errorf("items is required and must be a non-empty array")
+	}
+
+	items := make([]taskboard.BulkUpdateItem, 0, len(rawItems))
+	for _, raw := range rawItems {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each item must be an object with id and patch")
+		}
+
+		id, _ := item["id"].(string)
+		if id == "" {
+			return nil, fmt.Errorf("each item requires an id")
+		}
+
+		patch, _ := item["patch"].(map[string]interface{})
+		items = append(items, taskboard.BulkUpdateItem{
+			ID: id,
+			Patch: taskboard.UpdateTaskParams{
+				Title:       getStringPtr(patch, "title"),
+				Description: getStringPtr(patch, "description"),
+				Assignee:    getStringPtr(patch, "assignee"),
+				Status:      getStringPtr(patch, "status"),
+				Priority:    getIntPtr(patch, "priority"),
+			},
+		})
+	}
+
+	results, err := client.BulkUpdateTasks(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeBulkResults(results)
+	return summary, nil
+}
+
+func (s *Server) bulkCreateTasks(ctx context.Context, client *taskboard.Client, args map[string]interface{}) (interface{}, error) {
+	rawItems, ok := args["items"].([]interface{})
+	if !ok || len(rawItems) == 0 {
+		return nil, fmt.Errorf("items is required and must be a non-empty array")
+	}
+
+	items := make([]taskboard.CreateTaskParams, 0, len(rawItems))
+	for _, raw := range rawItems {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("each item must be an object")
+		}
+
+		boardID := getString(item, "board_id")
+		title := getString(item, "title")
+		if boardID == "" || title == "" {
+			return nil, fmt.Errorf("each item requires board_id and title")
+		}
+
+		items = append(items, taskboard.CreateTaskParams{
+			BoardID:     boardID,
+			Title:       title,
+			Description: getString(item, "description"),
+			Assignee:    getString(item, "assignee"),
+			Status:      getString(item, "status"),
+			Priority:    getInt(item, "priority"),
+		})
+	}
+
+	results, err := client.BulkCreateTasks(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeBulkResults(results)
+	return summary, nil
+}
@@ -0,0 +1,161 @@
+package mcp
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// sseTransport delivers JSON-RPC messages to one client as Server-Sent
+// Events, matching the MCP 2024-11-05 HTTP+SSE binding: the client opens a
+// GET /sse stream to receive responses and notifications, and POSTs
+// requests to the endpoint advertised in the stream's first event.
+type sseTransport struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func (t *sseTransport) Send(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := fmt.Fprintf(t.w, "event: message\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	t.flusher.Flush()
+	return nil
+}
+
+// HTTPServer exposes an MCP Server over HTTP+SSE: GET /sse opens the
+// notification/response stream for a session, POST /rpc submits a
+// JSON-RPC request against an existing session.
+type HTTPServer struct {
+	server    *Server
+	authToken string
+}
+
+// NewHTTPServer wraps server for HTTP+SSE transport. If authToken is
+// non-empty, every request must carry a matching "Authorization: Bearer
+// <authToken>" header. The presented bearer token is also forwarded as the
+// TaskBoard credential for that session's calls (see
+// Server.clientForSession), taking the place of the process-wide
+// TASKBOARD_TOKEN for that caller only. When authToken is empty, HTTP
+// callers are unauthenticated and their sessions fall back to
+// TASKBOARD_TOKEN like stdio sessions do.
+func NewHTTPServer(server *Server, authToken string) *HTTPServer {
+	return &HTTPServer{server: server, authToken: authToken}
+}
+
+func (h *HTTPServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sse", h.handleSSE)
+	mux.HandleFunc("/rpc", h.handleRPC)
+	return h.withAuth(mux)
+}
+
+func (h *HTTPServer) withAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.authToken != "" {
+			const prefix = "Bearer "
+			auth := r.Header.Get("Authorization")
+			if !strings.HasPrefix(auth, prefix) || !constantTimeEquals(strings.TrimPrefix(auth, prefix), h.authToken) {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *HTTPServer) handleSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	transport := &sseTransport{w: w, flusher: flusher}
+	session := h.server.NewSession(transport)
+	if h.authToken != "" {
+		session.taskboardToken = bearerToken(r)
+	}
+	defer h.server.CloseSession(session.ID)
+
+	// Tell the client where to POST requests for this session, per the
+	// MCP HTTP+SSE binding.
+	fmt.Fprintf(w, "event: endpoint\ndata: /rpc?session=%s\n\n", session.ID)
+	flusher.Flush()
+
+	<-r.Context().Done()
+}
+
+func (h *HTTPServer) handleRPC(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sessionID := r.URL.Query().Get("session")
+	if sessionID == "" {
+		http.Error(w, "missing session parameter", http.StatusBadRequest)
+		return
+	}
+	sess, ok := h.server.Session(sessionID)
+	if !ok {
+		http.Error(w, "unknown session", http.StatusNotFound)
+		return
+	}
+
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, &Response{
+			JSONRPC: "2.0",
+			Error:   &Error{Code: -32700, Message: "Parse error", Data: err.Error()},
+		})
+		return
+	}
+
+	resp := h.server.Handle(r.Context(), sess, &req)
+	if err := sess.transport.Send(resp); err != nil {
+		http.Error(w, "failed to deliver response on SSE stream", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// constantTimeEquals compares two bearer tokens without leaking their
+// length-prefix-independent equality via timing.
+func constantTimeEquals(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>"
+// header, or "" if the header is absent or doesn't use the Bearer scheme.
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(auth, prefix)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
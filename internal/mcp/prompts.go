@@ -0,0 +1,176 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MnemoShare/projectboard-mcp/internal/taskboard"
+)
+
+// PromptsCapability advertises support for prompts/list and prompts/get in
+// the initialize response.
+type PromptsCapability struct {
+	ListChanged bool `json:"listChanged,omitempty"`
+}
+
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+type Prompt struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+type PromptsListResult struct {
+	Prompts []Prompt `json:"prompts"`
+}
+
+type GetPromptParams struct {
+	Name      string            `json:"name"`
+	Arguments map[string]string `json:"arguments"`
+}
+
+type PromptMessage struct {
+	Role    string       `json:"role"`
+	Content ContentBlock `json:"content"`
+}
+
+type GetPromptResult struct {
+	Description string          `json:"description,omitempty"`
+	Messages    []PromptMessage `json:"messages"`
+}
+
+var promptDefs = []Prompt{
+	{
+		Name:        "triage_backlog",
+		Description: "Summarize the backlog for a board and suggest what to work on next",
+		Arguments: []PromptArgument{
+			{Name: "board_id", Description: "Board ID to triage", Required: true},
+		},
+	},
+	{
+		Name:        "summarize_task",
+		Description: "Summarize a single task's current state for a standup or handoff",
+		Arguments: []PromptArgument{
+			{Name: "id", Description: "Task ID or ticket number", Required: true},
+		},
+	},
+	{
+		Name:        "draft_standup",
+		Description: "Draft a standup update from a user's in-progress tasks",
+		Arguments: []PromptArgument{
+			{Name: "assignee", Description: "Assignee email", Required: true},
+		},
+	},
+}
+
+func (s *Server) handlePromptsList(req *Request) *Response {
+	return &Response{JSONRPC: "2.0", ID: req.ID, Result: PromptsListResult{Prompts: promptDefs}}
+}
+
+func (s *Server) handlePromptsGet(ctx context.Context, client *taskboard.Client, req *Request) *Response {
+	var params GetPromptParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: &Error{Code: -32602, Message: "Invalid params"}}
+	}
+
+	result, err := s.renderPrompt(ctx, client, params.Name, params.Arguments)
+	if err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: &Error{Code: -32602, Message: err.Error()}}
+	}
+
+	return &Response{JSONRPC: "2.0", ID: req.ID, Result: *result}
+}
+
+func (s *Server) renderPrompt(ctx context.Context, client *taskboard.Client, name string, args map[string]string) (*GetPromptResult, error) {
+	switch name {
+	case "triage_backlog":
+		return s.renderTriageBacklog(ctx, client, args["board_id"])
+	case "summarize_task":
+		return s.renderSummarizeTask(ctx, client, args["id"])
+	case "draft_standup":
+		return s.renderDraftStandup(ctx, client, args["assignee"])
+	default:
+		return nil, fmt.Errorf("unknown prompt: %s", name)
+	}
+}
+
+func (s *Server) renderTriageBacklog(ctx context.Context, client *taskboard.Client, boardID string) (*GetPromptResult, error) {
+	if boardID == "" {
+		return nil, fmt.Errorf("board_id is required")
+	}
+
+	tasks, err := client.ListTasksWithContext(ctx, boardID, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, t := range tasks {
+		lines = append(lines, fmt.Sprintf("- [%s] %s (status=%s, priority=%d, assignee=%s)",
+			t.TicketNumber, t.Title, t.Status, t.Priority, t.Assignee))
+	}
+
+	text := fmt.Sprintf("Here is the current backlog for board %s:\n\n%s\n\nTriage it: flag anything stale, call out missing assignees, and suggest the top 3 tasks to work on next.",
+		boardID, strings.Join(lines, "\n"))
+
+	return &GetPromptResult{
+		Description: "Triage a board's backlog",
+		Messages: []PromptMessage{
+			{Role: "user", Content: ContentBlock{Type: "text", Text: text}},
+		},
+	}, nil
+}
+
+func (s *Server) renderSummarizeTask(ctx context.Context, client *taskboard.Client, idOrTicket string) (*GetPromptResult, error) {
+	if idOrTicket == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	task, err := client.GetTaskWithContext(ctx, idOrTicket)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("Summarize this task in 2-3 sentences for a handoff:\n\n%s: %s\nStatus: %s | Priority: %d | Assignee: %s\nTags: %s\n\n%s",
+		task.TicketNumber, task.Title, task.Status, task.Priority, task.Assignee, strings.Join(task.Tags, ", "), task.Description)
+
+	return &GetPromptResult{
+		Description: "Summarize a task",
+		Messages: []PromptMessage{
+			{Role: "user", Content: ContentBlock{Type: "text", Text: text}},
+		},
+	}, nil
+}
+
+func (s *Server) renderDraftStandup(ctx context.Context, client *taskboard.Client, assignee string) (*GetPromptResult, error) {
+	if assignee == "" {
+		return nil, fmt.Errorf("assignee is required")
+	}
+
+	tasks, err := client.ListTasksWithContext(ctx, "", "in-progress", assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, t := range tasks {
+		lines = append(lines, fmt.Sprintf("- %s: %s (%s)", t.TicketNumber, t.Title, t.Status))
+	}
+
+	text := fmt.Sprintf("Draft a standup update for %s based on these tasks:\n\n%s\n\nGroup by yesterday/today/blockers.",
+		assignee, strings.Join(lines, "\n"))
+
+	return &GetPromptResult{
+		Description: "Draft a standup update",
+		Messages: []PromptMessage{
+			{Role: "user", Content: ContentBlock{Type: "text", Text: text}},
+		},
+	}, nil
+}
@@ -0,0 +1,109 @@
+package mcp
+
+import "encoding/json"
+
+// Request is one JSON-RPC 2.0 request, as sent by an MCP client over
+// stdio or HTTP+SSE (see RunStdio and HTTPServer). Params is left as raw
+// JSON so each method's handler can unmarshal it into its own params type.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      interface{}     `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is the JSON-RPC 2.0 reply to a Request. At most one of Result or
+// Error is set; the reply to "initialized" carries neither.
+type Response struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      interface{} `json:"id,omitempty"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *Error      `json:"error,omitempty"`
+}
+
+// Error is a JSON-RPC 2.0 error object. Code follows the JSON-RPC reserved
+// ranges for protocol-level errors (e.g. -32601 Method not found) and this
+// package's own -32000..-32099 range for tool-call failures (see the
+// errCode* constants in server.go).
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Property describes one field of a tool's InputSchema, following the
+// subset of JSON Schema that MCP tool definitions use.
+type Property struct {
+	Type        string   `json:"type"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+// InputSchema is a tool's parameter schema, advertised to clients via
+// tools/list so they know what arguments tools/call expects.
+type InputSchema struct {
+	Type       string              `json:"type"`
+	Properties map[string]Property `json:"properties,omitempty"`
+	Required   []string            `json:"required,omitempty"`
+}
+
+// Tool describes one callable tool, as returned by tools/list.
+type Tool struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	InputSchema InputSchema `json:"inputSchema"`
+}
+
+// ToolsCapability advertises support for tools/list and tools/call in the
+// initialize response.
+type ToolsCapability struct {
+	ListChanged bool `json:"listChanged,omitempty"`
+}
+
+// ServerCapabilities is the capabilities object returned from initialize,
+// advertising which optional MCP feature areas this server supports.
+type ServerCapabilities struct {
+	Tools     *ToolsCapability     `json:"tools,omitempty"`
+	Resources *ResourcesCapability `json:"resources,omitempty"`
+	Prompts   *PromptsCapability   `json:"prompts,omitempty"`
+}
+
+// ServerInfo identifies this server implementation in the initialize
+// response.
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// InitializeResult is the result of the initialize method.
+type InitializeResult struct {
+	ProtocolVersion string             `json:"protocolVersion"`
+	Capabilities    ServerCapabilities `json:"capabilities"`
+	ServerInfo      ServerInfo         `json:"serverInfo"`
+}
+
+// ToolsListResult is the result of tools/list.
+type ToolsListResult struct {
+	Tools []Tool `json:"tools"`
+}
+
+// CallToolParams is the params object for tools/call.
+type CallToolParams struct {
+	Name      string                 `json:"name"`
+	Arguments map[string]interface{} `json:"arguments"`
+}
+
+// ContentBlock is one piece of content in a tool result or prompt message.
+// Only the "text" type is produced by this server.
+type ContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text,omitempty"`
+}
+
+// CallToolResult is the result of tools/call. IsError signals a tool-level
+// failure (as opposed to a protocol-level JSON-RPC error) so clients can
+// still read the explanatory text in Content.
+type CallToolResult struct {
+	Content []ContentBlock `json:"content"`
+	IsError bool           `json:"isError,omitempty"`
+}
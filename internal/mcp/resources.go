@@ -0,0 +1,163 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MnemoShare/projectboard-mcp/internal/taskboard"
+)
+
+// ResourcesCapability advertises support for resources/list, resources/read,
+// and resources/subscribe in the initialize response.
+type ResourcesCapability struct {
+	Subscribe   bool `json:"subscribe,omitempty"`
+	ListChanged bool `json:"listChanged,omitempty"`
+}
+
+// Resource describes one addressable piece of TaskBoard data, identified by
+// a taskboard:// URI (e.g. taskboard://boards/{id}, taskboard://tasks/{ticket}).
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+type ResourcesListResult struct {
+	Resources []Resource `json:"resources"`
+}
+
+type ReadResourceParams struct {
+	URI string `json:"uri"`
+}
+
+type ResourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Text     string `json:"text,omitempty"`
+}
+
+type ResourcesReadResult struct {
+	Contents []ResourceContents `json:"contents"`
+}
+
+type SubscribeParams struct {
+	URI string `json:"uri"`
+}
+
+const (
+	resourceURIBoardPrefix = "taskboard://boards/"
+	resourceURITaskPrefix  = "taskboard://tasks/"
+)
+
+func (s *Server) handleResourcesList(ctx context.Context, client *taskboard.Client, req *Request) *Response {
+	boards, err := client.ListBoardsWithContext(ctx)
+	if err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: errorToMCPError(err)}
+	}
+
+	resources := make([]Resource, 0, len(boards))
+	for _, b := range boards {
+		resources = append(resources, Resource{
+			URI:         resourceURIBoardPrefix + b.ID,
+			Name:        b.Name,
+			Description: b.Description,
+			MimeType:    "application/json",
+		})
+	}
+
+	tasks, err := client.ListTasksWithContext(ctx, "", "", "")
+	if err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: errorToMCPError(err)}
+	}
+	for _, t := range tasks {
+		resources = append(resources, Resource{
+			URI:         resourceURITaskPrefix + t.TicketNumber,
+			Name:        fmt.Sprintf("%s: %s", t.TicketNumber, t.Title),
+			Description: t.Description,
+			MimeType:    "application/json",
+		})
+	}
+
+	return &Response{JSONRPC: "2.0", ID: req.ID, Result: ResourcesListResult{Resources: resources}}
+}
+
+func (s *Server) handleResourcesRead(ctx context.Context, client *taskboard.Client, req *Request) *Response {
+	var params ReadResourceParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: &Error{Code: -32602, Message: "Invalid params"}}
+	}
+
+	body, err := s.readResource(ctx, client, params.URI)
+	if err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: errorToMCPError(err)}
+	}
+
+	return &Response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: ResourcesReadResult{
+			Contents: []ResourceContents{{URI: params.URI, MimeType: "application/json", Text: string(body)}},
+		},
+	}
+}
+
+// readResource resolves a taskboard:// URI to its current JSON
+// representation, fetched live from the TaskBoard API.
+func (s *Server) readResource(ctx context.Context, client *taskboard.Client, uri string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(uri, resourceURIBoardPrefix):
+		id := strings.TrimPrefix(uri, resourceURIBoardPrefix)
+		boards, err := client.ListBoardsWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range boards {
+			if b.ID == id {
+				return json.MarshalIndent(b, "", "  ")
+			}
+		}
+		return nil, fmt.Errorf("no such board resource: %s", uri)
+
+	case strings.HasPrefix(uri, resourceURITaskPrefix):
+		ticket := strings.TrimPrefix(uri, resourceURITaskPrefix)
+		task, err := client.GetTaskWithContext(ctx, ticket)
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(task, "", "  ")
+
+	default:
+		return nil, fmt.Errorf("unsupported resource URI: %s", uri)
+	}
+}
+
+func (s *Server) handleResourcesSubscribe(sess *Session, req *Request) *Response {
+	var params SubscribeParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return &Response{JSONRPC: "2.0", ID: req.ID, Error: &Error{Code: -32602, Message: "Invalid params"}}
+	}
+
+	if sess != nil {
+		s.subscriptions.add(sess.ID, params.URI)
+	}
+
+	return &Response{JSONRPC: "2.0", ID: req.ID, Result: struct{}{}}
+}
+
+// NotifyResourceUpdated pushes notifications/resources/updated to every
+// session subscribed to uri. A future webhook or poller that learns a task
+// or board changed calls this to keep subscribers current.
+func (s *Server) NotifyResourceUpdated(uri string) {
+	for _, sessionID := range s.subscriptions.subscribers(uri) {
+		if sess, ok := s.Session(sessionID); ok {
+			sess.Notify(&Notification{
+				JSONRPC: "2.0",
+				Method:  "notifications/resources/updated",
+				Params:  map[string]string{"uri": uri},
+			})
+		}
+	}
+}
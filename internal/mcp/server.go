@@ -1,12 +1,26 @@
 package mcp
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/MnemoShare/projectboard-mcp/internal/taskboard"
 )
 
+// MCP server error codes for tool-call failures, in the -32000 to -32099
+// implementation-defined range reserved by JSON-RPC.
+const (
+	errCodeAPIError     = -32000
+	errCodeNotFound     = -32001
+	errCodeUnauthorized = -32002
+	errCodeForbidden    = -32003
+	errCodeConflict     = -32004
+	errCodeRateLimited  = -32005
+	errCodeValidation   = -32006
+)
+
 const (
 	ProtocolVersion = "2024-11-05"
 	ServerName      = "taskboard-mcp"
@@ -14,16 +28,49 @@ const (
 )
 
 type Server struct {
-	client *taskboard.Client
-	tools  []Tool
+	client        *taskboard.Client
+	tools         []Tool
+	sessions      *sessionRegistry
+	subscriptions *subscriptionRegistry
 }
 
 func NewServer(client *taskboard.Client) *Server {
-	s := &Server{client: client}
+	s := &Server{
+		client:        client,
+		sessions:      newSessionRegistry(),
+		subscriptions: newSubscriptionRegistry(),
+	}
 	s.registerTools()
 	return s
 }
 
+// NewSession registers a new client session backed by t and returns it.
+// Transports (stdio, HTTP+SSE) call this once per connected client and must
+// call CloseSession when the client disconnects.
+func (s *Server) NewSession(t Transport) *Session {
+	sess := newSession(t)
+	s.sessions.add(sess)
+	return sess
+}
+
+// CloseSession tears down the session with the given ID, if any, including
+// any resource subscriptions it made.
+func (s *Server) CloseSession(id string) {
+	s.sessions.remove(id)
+	s.subscriptions.removeSession(id)
+}
+
+// Session looks up a previously registered session by ID.
+func (s *Server) Session(id string) (*Session, bool) {
+	return s.sessions.get(id)
+}
+
+// NotifyToolsListChanged broadcasts notifications/tools/list_changed to
+// every connected session, per the MCP spec.
+func (s *Server) NotifyToolsListChanged() {
+	s.sessions.broadcast(&Notification{JSONRPC: "2.0", Method: "notifications/tools/list_changed"})
+}
+
 func (s *Server) registerTools() {
 	s.tools = []Tool{
 		{
@@ -121,10 +168,61 @@ func (s *Server) registerTools() {
 			Description: "List all team members (for task assignment)",
 			InputSchema: InputSchema{Type: "object"},
 		},
+		{
+			Name:        "bulk_update_tasks",
+			Description: "Update many tasks at once (e.g. reassign, retag, or transition in bulk). Each item is applied independently; a failure on one item does not block the others.",
+			InputSchema: InputSchema{
+				Type: "object",
+				Properties: map[string]Property{
+					"items": {
+						Type:        "array",
+						Description: `Tasks to update, each shaped {"id": "<task ID or ticket number>", "patch": {"title", "description", "assignee", "status", "priority"}}`,
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		{
+			Name:        "bulk_create_tasks",
+			Description: "Create many tasks at once. Each item is created independently; a failure on one item does not block the others.",
+			InputSchema: InputSchema{
+				Type: "object",
+				Properties: map[string]Property{
+					"items": {
+						Type:        "array",
+						Description: `Tasks to create, each shaped like the create_task arguments: {"board_id", "title", "description", "assignee", "status", "priority"}`,
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+	}
+}
+
+// clientForSession returns the TaskBoard client to use for sess's calls: a
+// client scoped to sess's forwarded bearer token if it presented one (see
+// Session.taskboardToken and NewHTTPServer), or the server's default client
+// otherwise (stdio sessions, or HTTP sessions with no auth configured).
+func (s *Server) clientForSession(sess *Session) *taskboard.Client {
+	if sess != nil && sess.taskboardToken != "" {
+		return s.client.WithToken(sess.taskboardToken)
 	}
+	return s.client
 }
 
-func (s *Server) Handle(req *Request) *Response {
+// Handle dispatches a single JSON-RPC request and returns its response. ctx
+// governs every TaskBoard API call made while handling req — transports
+// should pass a context tied to the inbound request/connection (e.g.
+// r.Context() for HTTP) so a client disconnecting cancels the in-flight
+// backend call. sess identifies the calling client, both for session-scoped
+// methods like resources/subscribe and to resolve which TaskBoard credential
+// backs this call (see clientForSession); it may be nil for transports (or
+// tests) that have no session concept, in which case subscribe calls are
+// accepted but not tracked against any particular client and the server's
+// default TaskBoard client is used.
+func (s *Server) Handle(ctx context.Context, sess *Session, req *Request) *Response {
+	client := s.clientForSession(sess)
+
 	switch req.Method {
 	case "initialize":
 		return s.handleInitialize(req)
@@ -133,7 +231,17 @@ func (s *Server) Handle(req *Request) *Response {
 	case "tools/list":
 		return s.handleToolsList(req)
 	case "tools/call":
-		return s.handleToolsCall(req)
+		return s.handleToolsCall(ctx, client, req)
+	case "resources/list":
+		return s.handleResourcesList(ctx, client, req)
+	case "resources/read":
+		return s.handleResourcesRead(ctx, client, req)
+	case "resources/subscribe":
+		return s.handleResourcesSubscribe(sess, req)
+	case "prompts/list":
+		return s.handlePromptsList(req)
+	case "prompts/get":
+		return s.handlePromptsGet(ctx, client, req)
 	default:
 		return &Response{
 			JSONRPC: "2.0",
@@ -150,7 +258,9 @@ func (s *Server) handleInitialize(req *Request) *Response {
 		Result: InitializeResult{
 			ProtocolVersion: ProtocolVersion,
 			Capabilities: ServerCapabilities{
-				Tools: &ToolsCapability{},
+				Tools:     &ToolsCapability{},
+				Resources: &ResourcesCapability{Subscribe: true},
+				Prompts:   &PromptsCapability{},
 			},
 			ServerInfo: ServerInfo{
 				Name:    ServerName,
@@ -168,7 +278,7 @@ func (s *Server) handleToolsList(req *Request) *Response {
 	}
 }
 
-func (s *Server) handleToolsCall(req *Request) *Response {
+func (s *Server) handleToolsCall(ctx context.Context, client *taskboard.Client, req *Request) *Response {
 	var params CallToolParams
 	if err := json.Unmarshal(req.Params, &params); err != nil {
 		return &Response{
@@ -178,8 +288,11 @@ func (s *Server) handleToolsCall(req *Request) *Response {
 		}
 	}
 
-	result, err := s.callTool(params.Name, params.Arguments)
+	result, err := s.callTool(ctx, client, params.Name, params.Arguments)
 	if err != nil {
+		if mcpErr := errorFromToolErr(err); mcpErr != nil {
+			return &Response{JSONRPC: "2.0", ID: req.ID, Error: mcpErr}
+		}
 		return &Response{
 			JSONRPC: "2.0",
 			ID:      req.ID,
@@ -201,23 +314,23 @@ func (s *Server) handleToolsCall(req *Request) *Response {
 	}
 }
 
-func (s *Server) callTool(name string, args map[string]interface{}) (interface{}, error) {
+func (s *Server) callTool(ctx context.Context, client *taskboard.Client, name string, args map[string]interface{}) (interface{}, error) {
 	switch name {
 	case "list_boards":
-		return s.client.ListBoards()
+		return client.ListBoardsWithContext(ctx)
 
 	case "list_tasks":
 		boardID, _ := args["board_id"].(string)
 		status, _ := args["status"].(string)
 		assignee, _ := args["assignee"].(string)
-		return s.client.ListTasks(boardID, status, assignee)
+		return client.ListTasksWithContext(ctx, boardID, status, assignee)
 
 	case "get_task":
 		id, ok := args["id"].(string)
 		if !ok || id == "" {
 			return nil, fmt.Errorf("id is required")
 		}
-		return s.client.GetTask(id)
+		return client.GetTaskWithContext(ctx, id)
 
 	case "create_task":
 		boardID, _ := args["board_id"].(string)
@@ -225,7 +338,7 @@ func (s *Server) callTool(name string, args map[string]interface{}) (interface{}
 		if boardID == "" || title == "" {
 			return nil, fmt.Errorf("board_id and title are required")
 		}
-		return s.client.CreateTask(taskboard.CreateTaskParams{
+		return client.CreateTaskWithContext(ctx, taskboard.CreateTaskParams{
 			BoardID:     boardID,
 			Title:       title,
 			Description: getString(args, "description"),
@@ -239,7 +352,7 @@ func (s *Server) callTool(name string, args map[string]interface{}) (interface{}
 		if id == "" {
 			return nil, fmt.Errorf("id is required")
 		}
-		return s.client.UpdateTask(id, taskboard.UpdateTaskParams{
+		return client.UpdateTaskWithContext(ctx, id, taskboard.UpdateTaskParams{
 			Title:       getStringPtr(args, "title"),
 			Description: getStringPtr(args, "description"),
 			Assignee:    getStringPtr(args, "assignee"),
@@ -253,16 +366,79 @@ func (s *Server) callTool(name string, args map[string]interface{}) (interface{}
 		if taskID == "" || text == "" {
 			return nil, fmt.Errorf("task_id and text are required")
 		}
-		return s.client.AddComment(taskID, text)
+		return client.AddCommentWithContext(ctx, taskID, text)
 
 	case "list_users":
-		return s.client.ListUsers()
+		return client.ListUsersWithContext(ctx)
+
+	case "bulk_update_tasks":
+		return s.bulkUpdateTasks(ctx, client, args)
+
+	case "bulk_create_tasks":
+		return s.bulkCreateTasks(ctx, client, args)
 
 	default:
 		return nil, fmt.Errorf("unknown tool: %s", name)
 	}
 }
 
+// errorFromToolErr translates a taskboard typed error (see
+// internal/taskboard/errors.go) into an MCP JSON-RPC error with a
+// structured Data payload, so agents can distinguish "ticket MNS-42 doesn't
+// exist" from "you cannot transition to closed" instead of parsing prose.
+// It returns nil for errors that aren't taskboard API errors, so callers
+// fall back to the generic isError tool-result path.
+func errorFromToolErr(err error) *Error {
+	var notFound *taskboard.ErrNotFound
+	var unauthorized *taskboard.ErrUnauthorized
+	var forbidden *taskboard.ErrForbidden
+	var conflict *taskboard.ErrConflict
+	var rateLimited *taskboard.ErrRateLimited
+	var validation *taskboard.ErrValidation
+	var apiErr *taskboard.APIError
+
+	switch {
+	case errors.As(err, &notFound):
+		return &Error{Code: errCodeNotFound, Message: notFound.Error(), Data: apiErrorData(notFound.APIError)}
+	case errors.As(err, &unauthorized):
+		return &Error{Code: errCodeUnauthorized, Message: unauthorized.Error(), Data: apiErrorData(unauthorized.APIError)}
+	case errors.As(err, &forbidden):
+		return &Error{Code: errCodeForbidden, Message: forbidden.Error(), Data: apiErrorData(forbidden.APIError)}
+	case errors.As(err, &conflict):
+		return &Error{Code: errCodeConflict, Message: conflict.Error(), Data: apiErrorData(conflict.APIError)}
+	case errors.As(err, &rateLimited):
+		data := apiErrorData(rateLimited.APIError)
+		data["retryAfterSeconds"] = rateLimited.RetryAfter.Seconds()
+		return &Error{Code: errCodeRateLimited, Message: rateLimited.Error(), Data: data}
+	case errors.As(err, &validation):
+		data := apiErrorData(validation.APIError)
+		data["fields"] = validation.Fields
+		return &Error{Code: errCodeValidation, Message: validation.Error(), Data: data}
+	case errors.As(err, &apiErr):
+		return &Error{Code: errCodeAPIError, Message: apiErr.Error(), Data: apiErrorData(apiErr)}
+	default:
+		return nil
+	}
+}
+
+// errorToMCPError is like errorFromToolErr but always returns a non-nil
+// *Error, falling back to errCodeAPIError with the error's message for
+// errors that aren't taskboard API errors (e.g. a local JSON marshal failure).
+func errorToMCPError(err error) *Error {
+	if mcpErr := errorFromToolErr(err); mcpErr != nil {
+		return mcpErr
+	}
+	return &Error{Code: errCodeAPIError, Message: err.Error()}
+}
+
+func apiErrorData(e *taskboard.APIError) map[string]interface{} {
+	return map[string]interface{}{
+		"statusCode": e.StatusCode,
+		"code":       e.Code,
+		"requestId":  e.RequestID,
+	}
+}
+
 func getString(args map[string]interface{}, key string) string {
 	v, _ := args[key].(string)
 	return v
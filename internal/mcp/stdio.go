@@ -0,0 +1,63 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// stdioTransport writes JSON-RPC messages to w, one per line, guarding
+// against interleaved writes between request replies and async
+// notifications.
+type stdioTransport struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (t *stdioTransport) Send(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err = fmt.Fprintln(t.w, string(data))
+	return err
+}
+
+// RunStdio serves the MCP protocol over stdin/stdout: one JSON-RPC request
+// per line in, one JSON-RPC response per line out. This is the original
+// transport and remains the default for local process-per-client usage.
+func RunStdio(server *Server, r io.Reader, w io.Writer) error {
+	transport := &stdioTransport{w: w}
+	session := server.NewSession(transport)
+	defer server.CloseSession(session.ID)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		var request Request
+		if err := json.Unmarshal([]byte(line), &request); err != nil {
+			_ = transport.Send(&Response{
+				JSONRPC: "2.0",
+				Error:   &Error{Code: -32700, Message: "Parse error", Data: err.Error()},
+			})
+			continue
+		}
+
+		response := server.Handle(context.Background(), session, &request)
+		if err := transport.Send(response); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
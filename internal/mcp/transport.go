@@ -0,0 +1,170 @@
+package mcp
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Notification is an unprompted server->client JSON-RPC message: it has a
+// method like a Request but no ID, since the client isn't expected to
+// reply. Used for things like notifications/tools/list_changed.
+type Notification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// Transport delivers a JSON-RPC message (a *Response or *Notification) to
+// one connected client. Request handling itself stays in Server.Handle,
+// which takes a parsed *Request and returns a *Response independent of any
+// transport; the stdio and HTTP+SSE transports both send those responses,
+// and the session's notifications, through their Transport.
+type Transport interface {
+	Send(v interface{}) error
+}
+
+// Session represents one connected MCP client. It pairs an outbound
+// Transport with a buffered queue of pending notifications, so a slow or
+// bursty push doesn't block whatever goroutine is driving the session
+// (e.g. an HTTP handler) and so multiple concurrent clients each get their
+// own delivery order.
+type Session struct {
+	ID string
+
+	// taskboardToken, if non-empty, is the bearer token this session's
+	// caller presented over HTTP, forwarded as this session's TaskBoard
+	// credential in place of the server's default token (see
+	// Server.clientForSession). Empty for stdio sessions and for HTTP
+	// sessions where no per-caller token was presented.
+	taskboardToken string
+
+	transport     Transport
+	notifications chan *Notification
+	done          chan struct{}
+}
+
+var sessionSeq int64
+
+func newSession(t Transport) *Session {
+	id := atomic.AddInt64(&sessionSeq, 1)
+	s := &Session{
+		ID:            fmt.Sprintf("sess-%d", id),
+		transport:     t,
+		notifications: make(chan *Notification, 32),
+		done:          make(chan struct{}),
+	}
+	go s.pump()
+	return s
+}
+
+func (s *Session) pump() {
+	for {
+		select {
+		case n := <-s.notifications:
+			_ = s.transport.Send(n)
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Notify enqueues a notification for delivery on this session's transport.
+// It never blocks: if the queue is full (a stalled or gone client), the
+// notification is dropped rather than wedging the caller.
+func (s *Session) Notify(n *Notification) {
+	select {
+	case s.notifications <- n:
+	default:
+	}
+}
+
+// Close stops the session's delivery goroutine. Callers must invoke it when
+// the underlying connection goes away.
+func (s *Session) Close() {
+	close(s.done)
+}
+
+// sessionRegistry tracks every connected Session so the server can
+// broadcast notifications (e.g. tools/list_changed) to all of them at once.
+type sessionRegistry struct {
+	mu       sync.RWMutex
+	sessions map[string]*Session
+}
+
+func newSessionRegistry() *sessionRegistry {
+	return &sessionRegistry{sessions: make(map[string]*Session)}
+}
+
+func (r *sessionRegistry) add(s *Session) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[s.ID] = s
+}
+
+func (r *sessionRegistry) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.sessions[id]; ok {
+		s.Close()
+		delete(r.sessions, id)
+	}
+}
+
+func (r *sessionRegistry) get(id string) (*Session, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.sessions[id]
+	return s, ok
+}
+
+func (r *sessionRegistry) broadcast(n *Notification) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.sessions {
+		s.Notify(n)
+	}
+}
+
+// subscriptionRegistry tracks which sessions are subscribed to which
+// resource URIs, so NotifyResourceUpdated knows who to push to.
+type subscriptionRegistry struct {
+	mu    sync.RWMutex
+	byURI map[string]map[string]struct{}
+}
+
+func newSubscriptionRegistry() *subscriptionRegistry {
+	return &subscriptionRegistry{byURI: make(map[string]map[string]struct{})}
+}
+
+func (r *subscriptionRegistry) add(sessionID, uri string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.byURI[uri] == nil {
+		r.byURI[uri] = make(map[string]struct{})
+	}
+	r.byURI[uri][sessionID] = struct{}{}
+}
+
+func (r *subscriptionRegistry) subscribers(uri string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]string, 0, len(r.byURI[uri]))
+	for id := range r.byURI[uri] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// removeSession drops sessionID from every URI it subscribed to, so a
+// disconnected client doesn't linger as a permanent subscriber.
+func (r *subscriptionRegistry) removeSession(sessionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for uri, subscribers := range r.byURI {
+		delete(subscribers, sessionID)
+		if len(subscribers) == 0 {
+			delete(r.byURI, uri)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package taskboard
+
+import (
+	"context"
+	"sync"
+)
+
+const defaultBulkConcurrency = 4
+
+// BulkOption configures a bulk operation's worker concurrency.
+type BulkOption func(*bulkConfig)
+
+type bulkConfig struct {
+	concurrency int
+}
+
+// WithBulkConcurrency overrides the number of concurrent workers used to
+// drive a bulk operation against the TaskBoard API. Default is 4.
+func WithBulkConcurrency(n int) BulkOption {
+	return func(c *bulkConfig) {
+		if n > 0 {
+			c.concurrency = n
+		}
+	}
+}
+
+func newBulkConfig(opts []BulkOption) bulkConfig {
+	cfg := bulkConfig{concurrency: defaultBulkConcurrency}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// BulkUpdateItem is one task to update in a BulkUpdateTasks call.
+type BulkUpdateItem struct {
+	ID    string           `json:"id"`
+	Patch UpdateTaskParams `json:"patch"`
+}
+
+// BulkResult is the per-item outcome of a bulk operation. Exactly one of
+// Task or Error is set.
+type BulkResult struct {
+	ID      string `json:"id"`
+	Task    *Task  `json:"task,omitempty"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkUpdateTasks applies each patch concurrently (bounded by concurrency,
+// default 4, see WithBulkConcurrency) and reports a per-item result rather
+// than aborting the whole batch on the first failure.
+func (c *Client) BulkUpdateTasks(ctx context.Context, items []BulkUpdateItem, opts ...BulkOption) ([]BulkResult, error) {
+	cfg := newBulkConfig(opts)
+	results := make([]BulkResult, len(items))
+	sem := make(chan struct{}, cfg.concurrency)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item BulkUpdateItem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			task, err := c.UpdateTaskWithContext(ctx, item.ID, item.Patch)
+			if err != nil {
+				results[i] = BulkResult{ID: item.ID, Error: err.Error()}
+				return
+			}
+			results[i] = BulkResult{ID: item.ID, Task: task, Success: true}
+		}(i, item)
+	}
+
+	wg.Wait()
+	return results, nil
+}
+
+// BulkCreateTasks creates each task concurrently (bounded by concurrency,
+// default 4, see WithBulkConcurrency) and reports a per-item result rather
+// than aborting the whole batch on the first failure.
+func (c *Client) BulkCreateTasks(ctx context.Context, items []CreateTaskParams, opts ...BulkOption) ([]BulkResult, error) {
+	cfg := newBulkConfig(opts)
+	results := make([]BulkResult, len(items))
+	sem := make(chan struct{}, cfg.concurrency)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, item CreateTaskParams) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			task, err := c.CreateTaskWithContext(ctx, item)
+			if err != nil {
+				results[i] = BulkResult{ID: item.Title, Error: err.Error()}
+				return
+			}
+			results[i] = BulkResult{ID: task.ID, Task: task, Success: true}
+		}(i, item)
+	}
+
+	wg.Wait()
+	return results, nil
+}
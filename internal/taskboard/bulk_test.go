@@ -0,0 +1,106 @@
+package taskboard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulkUpdateTasksPartialFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/tasks/by-ticket/"):
+			ticket := strings.TrimPrefix(r.URL.Path, "/api/tasks/by-ticket/")
+			if ticket == "BAD-1" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"_id":"` + ticket + `","ticketNumber":"` + ticket + `"}`))
+		case r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		case strings.HasPrefix(r.URL.Path, "/api/tasks/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/tasks/")
+			if id == "BAD-1" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"not found"}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"_id":"` + id + `","ticketNumber":"` + id + `"}`))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "tok")
+
+	items := []BulkUpdateItem{
+		{ID: "OK-1", Patch: UpdateTaskParams{}},
+		{ID: "BAD-1", Patch: UpdateTaskParams{}},
+		{ID: "OK-2", Patch: UpdateTaskParams{}},
+	}
+
+	results, err := c.BulkUpdateTasks(context.Background(), items)
+	if err != nil {
+		t.Fatalf("BulkUpdateTasks: %v", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("got %d results, want %d", len(results), len(items))
+	}
+
+	byID := make(map[string]BulkResult, len(results))
+	for _, r := range results {
+		byID[r.ID] = r
+	}
+
+	if !byID["OK-1"].Success || !byID["OK-2"].Success {
+		t.Fatalf("expected OK-1 and OK-2 to succeed, got %+v", results)
+	}
+	if byID["BAD-1"].Success || byID["BAD-1"].Error == "" {
+		t.Fatalf("expected BAD-1 to fail with a recorded error, got %+v", byID["BAD-1"])
+	}
+}
+
+func TestBulkCreateTasksRespectsConcurrencyLimit(t *testing.T) {
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"_id":"t","ticketNumber":"T-1"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "tok")
+
+	items := make([]CreateTaskParams, 10)
+	for i := range items {
+		items[i] = CreateTaskParams{BoardID: "b1", Title: "t"}
+	}
+
+	results, err := c.BulkCreateTasks(context.Background(), items, WithBulkConcurrency(2))
+	if err != nil {
+		t.Fatalf("BulkCreateTasks: %v", err)
+	}
+	if len(results) != len(items) {
+		t.Fatalf("got %d results, want %d", len(results), len(items))
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("max concurrent requests = %d, want <= 2", got)
+	}
+}
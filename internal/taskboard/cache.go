@@ -0,0 +1,201 @@
+package taskboard
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheEntry is a cached response body plus the ETag it was served with, so
+// a later request can be conditionally revalidated with If-None-Match.
+type CacheEntry struct {
+	Body      []byte
+	ETag      string
+	ExpiresAt time.Time
+}
+
+func (e CacheEntry) expired() bool {
+	return !e.ExpiresAt.IsZero() && time.Now().After(e.ExpiresAt)
+}
+
+// CacheStats reports cumulative cache activity for Client.Stats().
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+// Cache is the read-through cache used for GET requests, keyed by
+// "<method> <path>?<query>". The default implementation is LRU; callers can
+// plug in their own via WithCache.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+	// InvalidatePrefix drops every entry whose key's path is prefix itself
+	// or is nested under it (prefix + "/..."), e.g. invalidating
+	// "/api/tasks/123" drops "GET /api/tasks/123" and
+	// "GET /api/tasks/123/comments" but leaves "GET /api/tasks/456" alone.
+	InvalidatePrefix(prefix string)
+	// InvalidateExact drops only the entry whose key's path is exactly
+	// path, e.g. invalidating "/api/tasks" drops the list query
+	// "GET /api/tasks?boardId=b1" (whose path is "/api/tasks") without
+	// touching "/api/tasks/123".
+	InvalidateExact(path string)
+	Stats() CacheStats
+}
+
+// LRU is the default Cache implementation: a fixed-size, TTL-bounded,
+// least-recently-used cache.
+type LRU struct {
+	mu       sync.Mutex
+	size     int
+	ttl      time.Duration
+	ll       *list.List
+	elements map[string]*list.Element
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+type lruEntry struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewLRU creates an LRU cache holding at most size entries, each valid for
+// ttl before it's treated as a miss. A zero ttl means entries never expire
+// on their own (they can still be evicted for space or invalidated).
+func NewLRU(size int, ttl time.Duration) *LRU {
+	return &LRU{
+		size:     size,
+		ttl:      ttl,
+		ll:       list.New(),
+		elements: make(map[string]*list.Element),
+	}
+}
+
+func (c *LRU) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.elements[key]
+	if !ok {
+		atomic.AddInt64(&c.misses, 1)
+		return CacheEntry{}, false
+	}
+
+	entry := el.Value.(*lruEntry).entry
+	if entry.expired() {
+		c.ll.Remove(el)
+		delete(c.elements, key)
+		atomic.AddInt64(&c.misses, 1)
+		return CacheEntry{}, false
+	}
+
+	c.ll.MoveToFront(el)
+	atomic.AddInt64(&c.hits, 1)
+	return entry, true
+}
+
+func (c *LRU) Set(key string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl > 0 && entry.ExpiresAt.IsZero() {
+		entry.ExpiresAt = time.Now().Add(c.ttl)
+	}
+
+	if el, ok := c.elements[key]; ok {
+		el.Value.(*lruEntry).entry = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&lruEntry{key: key, entry: entry})
+	c.elements[key] = el
+
+	if c.size > 0 && c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.elements, oldest.Value.(*lruEntry).key)
+			atomic.AddInt64(&c.evictions, 1)
+		}
+	}
+}
+
+func (c *LRU) InvalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.elements {
+		keyPath := cacheKeyPath(key)
+		if keyPath == prefix || strings.HasPrefix(keyPath, prefix+"/") {
+			c.ll.Remove(el)
+			delete(c.elements, key)
+		}
+	}
+}
+
+func (c *LRU) InvalidateExact(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, el := range c.elements {
+		if cacheKeyPath(key) == path {
+			c.ll.Remove(el)
+			delete(c.elements, key)
+		}
+	}
+}
+
+func (c *LRU) Stats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadInt64(&c.hits),
+		Misses:    atomic.LoadInt64(&c.misses),
+		Evictions: atomic.LoadInt64(&c.evictions),
+	}
+}
+
+// cacheKey builds the cache key for a GET request to path (which may
+// already include a query string).
+func cacheKey(method, path string) string {
+	return method + " " + path
+}
+
+// cacheKeyPath extracts the path (sans method prefix and query string) from
+// a cache key, for prefix-matching against invalidation paths.
+func cacheKeyPath(key string) string {
+	_, path, ok := strings.Cut(key, " ")
+	if !ok {
+		path = key
+	}
+	if idx := strings.Index(path, "?"); idx >= 0 {
+		path = path[:idx]
+	}
+	return path
+}
+
+// invalidationTargets derives the cache keys to invalidate after a
+// successful mutating request to path: itemPrefix covers the exact
+// resource and anything nested under it (e.g. a PUT to "/api/tasks/123"
+// invalidates "/api/tasks/123" and "/api/tasks/123/comments" but not
+// "/api/tasks/456"), and collectionPath covers list queries against the
+// resource's collection (e.g. "/api/tasks?boardId=b1", whose cache key
+// path is the bare "/api/tasks") without touching any other item's entry.
+func invalidationTargets(path string) (itemPrefix, collectionPath string) {
+	if idx := strings.Index(path, "?"); idx >= 0 {
+		path = path[:idx]
+	}
+	itemPrefix = path
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(parts) >= 2 {
+		collectionPath = "/" + parts[0] + "/" + parts[1]
+	} else {
+		collectionPath = path
+	}
+	return itemPrefix, collectionPath
+}
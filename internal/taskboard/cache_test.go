@@ -0,0 +1,107 @@
+package taskboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUEvictsOldestWhenFull(t *testing.T) {
+	c := NewLRU(2, 0)
+
+	c.Set("GET /a", CacheEntry{Body: []byte("a")})
+	c.Set("GET /b", CacheEntry{Body: []byte("b")})
+	c.Set("GET /c", CacheEntry{Body: []byte("c")})
+
+	if _, ok := c.Get("GET /a"); ok {
+		t.Fatalf("expected /a to be evicted")
+	}
+	if _, ok := c.Get("GET /b"); !ok {
+		t.Fatalf("expected /b to still be cached")
+	}
+	if _, ok := c.Get("GET /c"); !ok {
+		t.Fatalf("expected /c to still be cached")
+	}
+
+	stats := c.Stats()
+	if stats.Evictions != 1 {
+		t.Fatalf("Evictions = %d, want 1", stats.Evictions)
+	}
+}
+
+func TestLRUTouchOnGetProtectsFromEviction(t *testing.T) {
+	c := NewLRU(2, 0)
+
+	c.Set("GET /a", CacheEntry{Body: []byte("a")})
+	c.Set("GET /b", CacheEntry{Body: []byte("b")})
+	c.Get("GET /a") // touch /a so /b becomes the least-recently-used entry
+	c.Set("GET /c", CacheEntry{Body: []byte("c")})
+
+	if _, ok := c.Get("GET /a"); !ok {
+		t.Fatalf("expected /a to survive eviction after being touched")
+	}
+	if _, ok := c.Get("GET /b"); ok {
+		t.Fatalf("expected /b to be evicted instead of /a")
+	}
+}
+
+func TestLRUEntryExpiresAfterTTL(t *testing.T) {
+	c := NewLRU(10, 10*time.Millisecond)
+
+	c.Set("GET /a", CacheEntry{Body: []byte("a")})
+	if _, ok := c.Get("GET /a"); !ok {
+		t.Fatalf("expected /a to be cached immediately after Set")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("GET /a"); ok {
+		t.Fatalf("expected /a to have expired after TTL")
+	}
+
+	stats := c.Stats()
+	if stats.Misses == 0 {
+		t.Fatalf("expected expiry to count as a miss")
+	}
+}
+
+func TestLRUInvalidatePrefix(t *testing.T) {
+	c := NewLRU(10, 0)
+
+	c.Set(cacheKey("GET", "/api/tasks/123"), CacheEntry{Body: []byte("task")})
+	c.Set(cacheKey("GET", "/api/tasks/123/comments"), CacheEntry{Body: []byte("comments")})
+	c.Set(cacheKey("GET", "/api/tasks/456"), CacheEntry{Body: []byte("other task")})
+	c.Set(cacheKey("GET", "/api/boards"), CacheEntry{Body: []byte("boards")})
+
+	itemPrefix, _ := invalidationTargets("/api/tasks/123")
+	c.InvalidatePrefix(itemPrefix)
+
+	if _, ok := c.Get(cacheKey("GET", "/api/tasks/123")); ok {
+		t.Fatalf("expected /api/tasks/123 to be invalidated")
+	}
+	if _, ok := c.Get(cacheKey("GET", "/api/tasks/123/comments")); ok {
+		t.Fatalf("expected /api/tasks/123/comments to be invalidated as nested under /api/tasks/123")
+	}
+	if _, ok := c.Get(cacheKey("GET", "/api/tasks/456")); !ok {
+		t.Fatalf("expected unrelated task /api/tasks/456 to survive invalidating /api/tasks/123")
+	}
+	if _, ok := c.Get(cacheKey("GET", "/api/boards")); !ok {
+		t.Fatalf("expected /api/boards entry to survive an unrelated invalidation")
+	}
+}
+
+func TestLRUInvalidateExactOnlyMatchesCollectionRoot(t *testing.T) {
+	c := NewLRU(10, 0)
+
+	c.Set(cacheKey("GET", "/api/tasks?boardId=b1"), CacheEntry{Body: []byte("list")})
+	c.Set(cacheKey("GET", "/api/tasks/123"), CacheEntry{Body: []byte("task")})
+
+	_, collectionPath := invalidationTargets("/api/tasks/123")
+	c.InvalidateExact(collectionPath)
+
+	if _, ok := c.Get(cacheKey("GET", "/api/tasks?boardId=b1")); ok {
+		t.Fatalf("expected the /api/tasks list entry to be invalidated")
+	}
+	if _, ok := c.Get(cacheKey("GET", "/api/tasks/123")); !ok {
+		t.Fatalf("expected /api/tasks/123 to survive an exact collection-root invalidation")
+	}
+}
@@ -2,20 +2,42 @@ package taskboard
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/big"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultMaxRetries  = 3
+	defaultBaseBackoff = 200 * time.Millisecond
+	defaultMaxBackoff  = 5 * time.Second
+	defaultUserAgent   = "projectboard-mcp"
 )
 
 type Client struct {
 	baseURL string
 	token   string
 	http    *http.Client
+
+	maxRetries  int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	idempotency func() string
+	userAgent   string
+	cache       Cache
 }
 
 type Config struct {
@@ -23,8 +45,92 @@ type Config struct {
 	Token string `json:"token"`
 }
 
+// ClientOption configures a Client returned by NewClient or NewClientFromEnv.
+type ClientOption func(*Client)
+
+// WithHTTPClient overrides the underlying *http.Client used for requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.http = hc
+	}
+}
+
+// WithTimeout sets a per-request timeout on the underlying *http.Client.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.http.Timeout = d
+	}
+}
+
+// WithRetryPolicy configures the number of retries and the exponential
+// backoff bounds applied to transient failures (429, 5xx, network timeouts).
+func WithRetryPolicy(maxRetries int, baseBackoff, maxBackoff time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.baseBackoff = baseBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithIdempotencyKey overrides how the client generates the Idempotency-Key
+// header sent on POST/PUT requests, so retries don't create duplicate data.
+func WithIdempotencyKey(fn func() string) ClientOption {
+	return func(c *Client) {
+		c.idempotency = fn
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithCache enables a read-through response cache for GET requests, keyed
+// by method, path, and query. Subsequent requests for the same key send
+// If-None-Match using the cached ETag and reuse the cached body on a 304.
+// Any successful mutating request invalidates the affected cache entries.
+// There is no cache by default.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithToken returns a shallow copy of c that authenticates as token instead
+// of c's own token, sharing the same HTTP client, retry policy, and cache.
+// Callers use this to scope a single per-caller credential (e.g. a bearer
+// token forwarded from an MCP session) to the calls made with the returned
+// Client, without affecting c or any other caller.
+func (c *Client) WithToken(token string) *Client {
+	clone := *c
+	clone.token = token
+	return &clone
+}
+
+// NewClient creates a Client for the given TaskBoard base URL and API token.
+func NewClient(baseURL, token string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		token:       token,
+		http:        &http.Client{Timeout: defaultTimeout},
+		maxRetries:  defaultMaxRetries,
+		baseBackoff: defaultBaseBackoff,
+		maxBackoff:  defaultMaxBackoff,
+		idempotency: newIdempotencyKey,
+		userAgent:   defaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
 // NewClientFromEnv creates a client from environment variables or config file
-func NewClientFromEnv() (*Client, error) {
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
 	// Try environment variables first
 	baseURL := os.Getenv("TASKBOARD_URL")
 	token := os.Getenv("TASKBOARD_TOKEN")
@@ -49,11 +155,16 @@ func NewClientFromEnv() (*Client, error) {
 		return nil, fmt.Errorf("TASKBOARD_TOKEN not set (env or config)")
 	}
 
-	return &Client{
-		baseURL: strings.TrimSuffix(baseURL, "/"),
-		token:   token,
-		http:    &http.Client{},
-	}, nil
+	return NewClient(baseURL, token, opts...), nil
+}
+
+// Stats returns the response cache's cumulative hit/miss/eviction counts.
+// It's a zero CacheStats if no cache was configured via WithCache.
+func (c *Client) Stats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.Stats()
 }
 
 func loadConfig() (*Config, error) {
@@ -76,40 +187,161 @@ func loadConfig() (*Config, error) {
 	return &cfg, nil
 }
 
-func (c *Client) request(method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+// newIdempotencyKey is the default Idempotency-Key generator. It avoids
+// pulling in an external uuid/xid dependency for what is just a unique,
+// opaque, URL-safe token.
+func newIdempotencyKey() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("idem-%d", time.Now().UnixNano())
+	}
+	return fmt.Sprintf("%x", buf)
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// isTransientNetErr reports whether err is a net.Error that timed out, the
+// only network failure treated as transient and worth retrying; any other
+// error, such as a connection reset or an invalid URL, is not retried.
+func isTransientNetErr(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+	return false
+}
+
+// backoffWithJitter returns the delay before retry attempt n (0-indexed),
+// exponential in n and bounded by maxBackoff, with up to 50% random jitter.
+func backoffWithJitter(attempt int, base, max time.Duration) time.Duration {
+	d := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if d > max {
+		d = max
+	}
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(d)/2+1))
+	if err != nil {
+		return d
+	}
+	return d/2 + time.Duration(jitter.Int64())
+}
+
+func (c *Client) request(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	var bodyBytes []byte
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		reqBody = bytes.NewReader(data)
+		bodyBytes = data
 	}
 
-	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
-	if err != nil {
-		return nil, err
+	idemKey := ""
+	if isIdempotent(method) && c.idempotency != nil {
+		idemKey = c.idempotency()
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, err
+	var cacheK string
+	var cached CacheEntry
+	var haveCached bool
+	if method == http.MethodGet && c.cache != nil {
+		cacheK = cacheKey(method, path)
+		cached, haveCached = c.cache.Get(cacheK)
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	var lastErr error
+	var retryAfter time.Duration
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := backoffWithJitter(attempt-1, c.baseBackoff, c.maxBackoff)
+			if retryAfter > 0 {
+				delay = retryAfter
+				retryAfter = 0
+			}
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", c.userAgent)
+		if idemKey != "" {
+			req.Header.Set("Idempotency-Key", idemKey)
+		}
+		if haveCached && cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if attempt < c.maxRetries && isTransientNetErr(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = err
+			if attempt < c.maxRetries {
+				continue
+			}
+			return nil, err
+		}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+		if resp.StatusCode == http.StatusNotModified && haveCached {
+			return cached.Body, nil
+		}
+
+		if resp.StatusCode >= 400 {
+			apiErr := newAPIError(resp.StatusCode, resp.Header, respBody)
+			if attempt < c.maxRetries && isRetryableStatus(resp.StatusCode) {
+				lastErr = apiErr
+				if rateLimited, ok := apiErr.(*ErrRateLimited); ok {
+					retryAfter = rateLimited.RetryAfter
+				}
+				continue
+			}
+			return nil, apiErr
+		}
+
+		if c.cache != nil {
+			if method == http.MethodGet {
+				c.cache.Set(cacheK, CacheEntry{Body: respBody, ETag: resp.Header.Get("ETag")})
+			} else {
+				itemPrefix, collectionPath := invalidationTargets(path)
+				c.cache.InvalidatePrefix(itemPrefix)
+				c.cache.InvalidateExact(collectionPath)
+			}
+		}
+
+		return respBody, nil
 	}
 
-	return respBody, nil
+	return nil, lastErr
 }
 
 // Board types
@@ -141,9 +373,17 @@ type User struct {
 }
 
 // API methods
+//
+// Each method has a `...WithContext` variant that takes a context.Context for
+// request-scoped cancellation; the context-less variant calls it with
+// context.Background() for callers that don't need cancellation.
 
 func (c *Client) ListBoards() ([]Board, error) {
-	data, err := c.request("GET", "/api/boards", nil)
+	return c.ListBoardsWithContext(context.Background())
+}
+
+func (c *Client) ListBoardsWithContext(ctx context.Context) ([]Board, error) {
+	data, err := c.request(ctx, "GET", "/api/boards", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +397,10 @@ func (c *Client) ListBoards() ([]Board, error) {
 }
 
 func (c *Client) ListTasks(boardID, status, assignee string) ([]Task, error) {
+	return c.ListTasksWithContext(context.Background(), boardID, status, assignee)
+}
+
+func (c *Client) ListTasksWithContext(ctx context.Context, boardID, status, assignee string) ([]Task, error) {
 	params := url.Values{}
 	if boardID != "" {
 		params.Set("boardId", boardID)
@@ -173,7 +417,7 @@ func (c *Client) ListTasks(boardID, status, assignee string) ([]Task, error) {
 		path += "?" + params.Encode()
 	}
 
-	data, err := c.request("GET", path, nil)
+	data, err := c.request(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -187,9 +431,13 @@ func (c *Client) ListTasks(boardID, status, assignee string) ([]Task, error) {
 }
 
 func (c *Client) GetTask(idOrTicket string) (*Task, error) {
+	return c.GetTaskWithContext(context.Background(), idOrTicket)
+}
+
+func (c *Client) GetTaskWithContext(ctx context.Context, idOrTicket string) (*Task, error) {
 	// Try ticket number first (e.g., MNS-42)
 	if strings.Contains(idOrTicket, "-") {
-		data, err := c.request("GET", "/api/tasks/by-ticket/"+idOrTicket, nil)
+		data, err := c.request(ctx, "GET", "/api/tasks/by-ticket/"+idOrTicket, nil)
 		if err == nil {
 			var task Task
 			if json.Unmarshal(data, &task) == nil {
@@ -199,7 +447,7 @@ func (c *Client) GetTask(idOrTicket string) (*Task, error) {
 	}
 
 	// Fall back to ID
-	data, err := c.request("GET", "/api/tasks/"+idOrTicket, nil)
+	data, err := c.request(ctx, "GET", "/api/tasks/"+idOrTicket, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -222,7 +470,11 @@ type CreateTaskParams struct {
 }
 
 func (c *Client) CreateTask(params CreateTaskParams) (*Task, error) {
-	data, err := c.request("POST", "/api/tasks", params)
+	return c.CreateTaskWithContext(context.Background(), params)
+}
+
+func (c *Client) CreateTaskWithContext(ctx context.Context, params CreateTaskParams) (*Task, error) {
+	data, err := c.request(ctx, "POST", "/api/tasks", params)
 	if err != nil {
 		return nil, err
 	}
@@ -244,19 +496,23 @@ type UpdateTaskParams struct {
 }
 
 func (c *Client) UpdateTask(idOrTicket string, params UpdateTaskParams) (*Task, error) {
+	return c.UpdateTaskWithContext(context.Background(), idOrTicket, params)
+}
+
+func (c *Client) UpdateTaskWithContext(ctx context.Context, idOrTicket string, params UpdateTaskParams) (*Task, error) {
 	// Resolve ticket number to ID if needed
-	task, err := c.GetTask(idOrTicket)
+	task, err := c.GetTaskWithContext(ctx, idOrTicket)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = c.request("PUT", "/api/tasks/"+task.ID, params)
+	_, err = c.request(ctx, "PUT", "/api/tasks/"+task.ID, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Fetch updated task
-	return c.GetTask(task.ID)
+	return c.GetTaskWithContext(ctx, task.ID)
 }
 
 type AddCommentParams struct {
@@ -264,13 +520,17 @@ type AddCommentParams struct {
 }
 
 func (c *Client) AddComment(idOrTicket, text string) (map[string]interface{}, error) {
+	return c.AddCommentWithContext(context.Background(), idOrTicket, text)
+}
+
+func (c *Client) AddCommentWithContext(ctx context.Context, idOrTicket, text string) (map[string]interface{}, error) {
 	// Resolve ticket number to ID if needed
-	task, err := c.GetTask(idOrTicket)
+	task, err := c.GetTaskWithContext(ctx, idOrTicket)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := c.request("POST", "/api/tasks/"+task.ID+"/comments", AddCommentParams{Text: text})
+	data, err := c.request(ctx, "POST", "/api/tasks/"+task.ID+"/comments", AddCommentParams{Text: text})
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +541,11 @@ func (c *Client) AddComment(idOrTicket, text string) (map[string]interface{}, er
 }
 
 func (c *Client) ListUsers() ([]User, error) {
-	data, err := c.request("GET", "/api/users", nil)
+	return c.ListUsersWithContext(context.Background())
+}
+
+func (c *Client) ListUsersWithContext(ctx context.Context) ([]User, error) {
+	data, err := c.request(ctx, "GET", "/api/users", nil)
 	if err != nil {
 		return nil, err
 	}
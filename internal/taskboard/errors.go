@@ -0,0 +1,180 @@
+package taskboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// APIError represents a non-2xx response from the TaskBoard API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("API error %d: %s (request %s)", e.StatusCode, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Message)
+}
+
+// ErrNotFound indicates the requested board, task, or user does not exist.
+type ErrNotFound struct{ *APIError }
+
+// ErrUnauthorized indicates the request's credentials were missing or invalid.
+type ErrUnauthorized struct{ *APIError }
+
+// ErrForbidden indicates the caller is authenticated but not permitted to
+// perform the requested operation.
+type ErrForbidden struct{ *APIError }
+
+// ErrConflict indicates the request conflicts with the current state of the
+// resource, e.g. an invalid status transition.
+type ErrConflict struct{ *APIError }
+
+// ErrRateLimited indicates the caller has been rate limited by the API.
+// RetryAfter is parsed from the Retry-After header, if present.
+type ErrRateLimited struct {
+	*APIError
+	RetryAfter time.Duration
+}
+
+// ErrValidation indicates the request body failed server-side validation.
+// Fields maps field name to a human-readable validation message.
+type ErrValidation struct {
+	*APIError
+	Fields map[string]string
+}
+
+func (e *ErrValidation) Error() string {
+	if len(e.Fields) == 0 {
+		return e.APIError.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.APIError.Error(), e.Fields)
+}
+
+// errorBody is the decoded shape of a TaskBoard API error response. The API
+// is not fully consistent about the field name, so both "error" and
+// "message" are accepted.
+type errorBody struct {
+	Error     string            `json:"error"`
+	Message   string            `json:"message"`
+	Code      string            `json:"code"`
+	RequestID string            `json:"requestId"`
+	Fields    map[string]string `json:"fields"`
+}
+
+// decodeErrorBody tolerates a JSON error body in either {"error":...} or
+// {"message":...} shape, as well as a plain-text body that isn't JSON at all.
+func decodeErrorBody(status int, body []byte) errorBody {
+	var b errorBody
+	if err := json.Unmarshal(body, &b); err == nil && (b.Error != "" || b.Message != "" || b.Code != "") {
+		return b
+	}
+	return errorBody{Message: string(body)}
+}
+
+// newAPIError builds the typed error for a non-2xx response, parsing
+// Retry-After and validation details where applicable.
+func newAPIError(status int, header http.Header, body []byte) error {
+	decoded := decodeErrorBody(status, body)
+	message := decoded.Message
+	if message == "" {
+		message = decoded.Error
+	}
+
+	base := &APIError{
+		StatusCode: status,
+		Code:       decoded.Code,
+		Message:    message,
+		RequestID:  decoded.RequestID,
+	}
+
+	switch {
+	case status == http.StatusNotFound:
+		return &ErrNotFound{base}
+	case status == http.StatusUnauthorized:
+		return &ErrUnauthorized{base}
+	case status == http.StatusForbidden:
+		return &ErrForbidden{base}
+	case status == http.StatusConflict:
+		return &ErrConflict{base}
+	case status == http.StatusTooManyRequests:
+		return &ErrRateLimited{APIError: base, RetryAfter: parseRetryAfter(header.Get("Retry-After"))}
+	case status == http.StatusUnprocessableEntity || status == http.StatusBadRequest:
+		return &ErrValidation{APIError: base, Fields: decoded.Fields}
+	default:
+		return base
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which per RFC 7231 may be
+// either a number of seconds or an HTTP-date. Unparseable or empty values
+// yield a zero duration.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// Is reports whether target is a bare *APIError with the same StatusCode as
+// e. It does not match the typed subtypes below (*ErrNotFound etc.) since
+// those carry their own Is methods; comparing against one of them falls
+// straight to errors.Is's default identity check unless it implements Is,
+// which is why each subtype below defines one.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return e.StatusCode == t.StatusCode
+}
+
+// Is reports whether target is also an *ErrNotFound, so callers can write
+// errors.Is(err, &taskboard.ErrNotFound{}) to check the error category
+// without caring about the wrapped APIError's fields.
+func (e *ErrNotFound) Is(target error) bool { _, ok := target.(*ErrNotFound); return ok }
+
+// Is reports whether target is also an *ErrUnauthorized.
+func (e *ErrUnauthorized) Is(target error) bool { _, ok := target.(*ErrUnauthorized); return ok }
+
+// Is reports whether target is also an *ErrForbidden.
+func (e *ErrForbidden) Is(target error) bool { _, ok := target.(*ErrForbidden); return ok }
+
+// Is reports whether target is also an *ErrConflict.
+func (e *ErrConflict) Is(target error) bool { _, ok := target.(*ErrConflict); return ok }
+
+// Is reports whether target is also an *ErrRateLimited.
+func (e *ErrRateLimited) Is(target error) bool { _, ok := target.(*ErrRateLimited); return ok }
+
+// Is reports whether target is also an *ErrValidation.
+func (e *ErrValidation) Is(target error) bool { _, ok := target.(*ErrValidation); return ok }
+
+var _ error = (*ErrNotFound)(nil)
+var _ error = (*ErrUnauthorized)(nil)
+var _ error = (*ErrForbidden)(nil)
+var _ error = (*ErrConflict)(nil)
+var _ error = (*ErrRateLimited)(nil)
+var _ error = (*ErrValidation)(nil)
+
+// Unwrap exposes the embedded *APIError so errors.As(err, &apiErr), with
+// apiErr of type *taskboard.APIError, still matches through any of the typed
+// subtypes above.
+func (e *ErrNotFound) Unwrap() error     { return e.APIError }
+func (e *ErrUnauthorized) Unwrap() error { return e.APIError }
+func (e *ErrForbidden) Unwrap() error    { return e.APIError }
+func (e *ErrConflict) Unwrap() error     { return e.APIError }
+func (e *ErrRateLimited) Unwrap() error  { return e.APIError }
+func (e *ErrValidation) Unwrap() error   { return e.APIError }
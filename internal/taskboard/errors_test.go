@@ -0,0 +1,90 @@
+package taskboard
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDecodeErrorBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantMsg string
+	}{
+		{name: "error shape", body: `{"error":"not found"}`, wantMsg: "not found"},
+		{name: "message shape", body: `{"message":"bad input"}`, wantMsg: "bad input"},
+		{name: "plain text", body: "internal server error", wantMsg: "internal server error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := decodeErrorBody(500, []byte(tt.body))
+			got := b.Message
+			if got == "" {
+				got = b.Error
+			}
+			if got != tt.wantMsg {
+				t.Fatalf("got message %q, want %q", got, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorMapsStatusToSubtype(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{http.StatusNotFound, func(err error) bool { var e *ErrNotFound; return errors.As(err, &e) }},
+		{http.StatusUnauthorized, func(err error) bool { var e *ErrUnauthorized; return errors.As(err, &e) }},
+		{http.StatusForbidden, func(err error) bool { var e *ErrForbidden; return errors.As(err, &e) }},
+		{http.StatusConflict, func(err error) bool { var e *ErrConflict; return errors.As(err, &e) }},
+		{http.StatusTooManyRequests, func(err error) bool { var e *ErrRateLimited; return errors.As(err, &e) }},
+		{http.StatusBadRequest, func(err error) bool { var e *ErrValidation; return errors.As(err, &e) }},
+	}
+
+	for _, tt := range tests {
+		err := newAPIError(tt.status, http.Header{}, []byte(`{"message":"boom"}`))
+		if !tt.check(err) {
+			t.Errorf("status %d: error %T did not match expected subtype", tt.status, err)
+		}
+	}
+}
+
+func TestErrRateLimitedParsesRetryAfter(t *testing.T) {
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+	err := newAPIError(http.StatusTooManyRequests, header, []byte(`{}`))
+
+	var rl *ErrRateLimited
+	if !errors.As(err, &rl) {
+		t.Fatalf("expected *ErrRateLimited, got %T", err)
+	}
+	if rl.RetryAfter.Seconds() != 30 {
+		t.Fatalf("RetryAfter = %v, want 30s", rl.RetryAfter)
+	}
+}
+
+func TestErrNotFoundIsMatchesCategoryNotFields(t *testing.T) {
+	err := newAPIError(http.StatusNotFound, http.Header{}, []byte(`{"message":"task 1 not found"}`))
+
+	if !errors.Is(err, &ErrNotFound{}) {
+		t.Fatalf("errors.Is(err, &ErrNotFound{}) = false, want true")
+	}
+	if errors.Is(err, &ErrForbidden{}) {
+		t.Fatalf("errors.Is(err, &ErrForbidden{}) = true, want false")
+	}
+}
+
+func TestErrorsAsUnwrapsToAPIError(t *testing.T) {
+	err := newAPIError(http.StatusNotFound, http.Header{}, []byte(`{"message":"gone"}`))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(err, &apiErr) = false, want true")
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusNotFound)
+	}
+}
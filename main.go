@@ -1,10 +1,9 @@
 package main
 
 import (
-	"bufio"
-	"encoding/json"
-	"fmt"
+	"flag"
 	"log"
+	"net/http"
 	"os"
 
 	"github.com/MnemoShare/projectboard-mcp/internal/mcp"
@@ -12,6 +11,10 @@ import (
 )
 
 func main() {
+	transport := flag.String("transport", "stdio", "MCP transport: stdio or http")
+	addr := flag.String("addr", ":8080", "listen address for --transport=http")
+	flag.Parse()
+
 	// Initialize TaskBoard client
 	client, err := taskboard.NewClientFromEnv()
 	if err != nil {
@@ -21,38 +24,18 @@ func main() {
 	// Create MCP server
 	server := mcp.NewServer(client)
 
-	// Run stdio transport
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		line := scanner.Text()
-		
-		var request mcp.Request
-		if err := json.Unmarshal([]byte(line), &request); err != nil {
-			sendError(nil, -32700, "Parse error", err.Error())
-			continue
+	switch *transport {
+	case "stdio":
+		if err := mcp.RunStdio(server, os.Stdin, os.Stdout); err != nil {
+			log.Fatalf("Error reading stdin: %v", err)
 		}
-
-		response := server.Handle(&request)
-		
-		respBytes, _ := json.Marshal(response)
-		fmt.Println(string(respBytes))
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("Error reading stdin: %v", err)
-	}
-}
-
-func sendError(id interface{}, code int, message, data string) {
-	resp := mcp.Response{
-		JSONRPC: "2.0",
-		ID:      id,
-		Error: &mcp.Error{
-			Code:    code,
-			Message: message,
-			Data:    data,
-		},
+	case "http":
+		httpServer := mcp.NewHTTPServer(server, os.Getenv("MCP_HTTP_TOKEN"))
+		log.Printf("MCP server listening on %s (SSE: GET /sse, RPC: POST /rpc)", *addr)
+		if err := http.ListenAndServe(*addr, httpServer.Handler()); err != nil {
+			log.Fatalf("HTTP server failed: %v", err)
+		}
+	default:
+		log.Fatalf("unknown transport %q (want stdio or http)", *transport)
 	}
-	respBytes, _ := json.Marshal(resp)
-	fmt.Println(string(respBytes))
 }